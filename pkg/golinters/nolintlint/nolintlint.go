@@ -5,21 +5,39 @@ import (
 	"fmt"
 	"go/ast"
 	"go/token"
+	"os"
+	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"unicode"
 )
 
+// TextEdit describes a mechanical rewrite of the source between Pos and End.
+// A nil NewText means the span should be deleted.
+type TextEdit struct {
+	Pos     token.Pos
+	End     token.Pos
+	NewText []byte
+}
+
 type BaseIssue struct {
 	fullDirective                     string
 	directiveWithOptionalLeadingSpace string
 	position                          token.Position
+	replacement                       *TextEdit
 }
 
 func (b BaseIssue) Position() token.Position {
 	return b.position
 }
 
+// Replacement returns the suggested fix for this issue, or nil if the issue
+// cannot be repaired mechanically (e.g. an ambiguous parse error).
+func (b BaseIssue) Replacement() *TextEdit {
+	return b.replacement
+}
+
 type ExtraLeadingSpace struct {
 	BaseIssue
 }
@@ -92,6 +110,69 @@ func (i UnusedCandidate) Details() string {
 
 func (i UnusedCandidate) String() string { return toString(i) }
 
+type UnmatchedBlockStart struct {
+	BaseIssue
+}
+
+func (i UnmatchedBlockStart) Details() string {
+	return fmt.Sprintf("directive `%s` starts a block that is never closed with a matching `end`", i.fullDirective)
+}
+
+func (i UnmatchedBlockStart) String() string { return toString(i) }
+
+type UnmatchedBlockEnd struct {
+	BaseIssue
+}
+
+func (i UnmatchedBlockEnd) Details() string {
+	return fmt.Sprintf("directive `%s` ends a block that was never started, or does not match the linters of its start", i.fullDirective)
+}
+
+func (i UnmatchedBlockEnd) String() string { return toString(i) }
+
+type InvalidPattern struct {
+	BaseIssue
+	Linter string
+	Err    error
+}
+
+func (i InvalidPattern) Details() string {
+	return fmt.Sprintf("directive `%s` has an invalid linter name pattern %q: %s", i.fullDirective, i.Linter, i.Err)
+}
+
+func (i InvalidPattern) String() string { return toString(i) }
+
+type OverbroadFileDirective struct {
+	BaseIssue
+}
+
+func (i OverbroadFileDirective) Details() string {
+	return fmt.Sprintf("file-level directive `%s` should mention specific linter such as `%s:my-linter`",
+		i.fullDirective, i.directiveWithOptionalLeadingSpace)
+}
+
+func (i OverbroadFileDirective) String() string { return toString(i) }
+
+type WeakExplanation struct {
+	BaseIssue
+}
+
+func (i WeakExplanation) Details() string {
+	return fmt.Sprintf("directive `%s` explanation is too weak to justify suppressing the linter", i.fullDirective)
+}
+
+func (i WeakExplanation) String() string { return toString(i) }
+
+type MissingExplanationRef struct {
+	BaseIssue
+}
+
+func (i MissingExplanationRef) Details() string {
+	return fmt.Sprintf("directive `%s` explanation should reference a tracked reason", i.fullDirective)
+}
+
+func (i MissingExplanationRef) String() string { return toString(i) }
+
 func toString(i Issue) string {
 	return fmt.Sprintf("%s at %s", i.Details(), i.Position())
 }
@@ -99,6 +180,7 @@ func toString(i Issue) string {
 type Issue interface {
 	Details() string
 	Position() token.Position
+	Replacement() *TextEdit
 	String() string
 }
 
@@ -109,36 +191,170 @@ const (
 	NeedsSpecific
 	NeedsExplanation
 	NeedsUnused
+	NeedsBlockPaired
 	NeedsAll = NeedsMachineOnly | NeedsSpecific | NeedsExplanation
 )
 
-var commentPattern = regexp.MustCompile(`^//\s*(nolint)(:\s*[\w-]+\s*(?:,\s*[\w-]+\s*)*)?\b`)
+var commentPattern = regexp.MustCompile(`^//\s*(nolint)(:\s*[\w*?\[\]-]+\s*(?:,\s*[\w*?\[\]-]+\s*)*)?\b`)
+
+// matches a complete nolint directive, optionally followed by a "start"/"end" block
+// marker before the trailing explanation
+var fullDirectivePattern = regexp.MustCompile(`^//\s*nolint(:\s*[\w*?\[\]-]+\s*(?:,\s*[\w*?\[\]-]+\s*)*)?(\s+(?i:start|end))?\s*(//.*)?\s*\n?$`)
+
+// ignoredRange marks a contiguous set of lines over which a matched
+// start/end block directive suppresses issues for the given linters (nil
+// means all linters).
+type ignoredRange struct {
+	linters []string
+	col     int
+	Start   int
+	End     int
+}
+
+// matches reports whether the range covers line for the given linter.
+func (r *ignoredRange) matches(line int, linter string) bool {
+	if line < r.Start || line > r.End {
+		return false
+	}
+
+	if len(r.linters) == 0 {
+		return true
+	}
+
+	for _, l := range r.linters {
+		if l == linter {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ignoredRanges is sortable by the line on which each range ends, which is
+// how the nolint processor walks ranges while scanning issues in order.
+type ignoredRanges []*ignoredRange
+
+func (rs ignoredRanges) Len() int           { return len(rs) }
+func (rs ignoredRanges) Less(i, j int) bool { return rs[i].End < rs[j].End }
+func (rs ignoredRanges) Swap(i, j int)      { rs[i], rs[j] = rs[j], rs[i] }
+
+var _ sort.Interface = ignoredRanges(nil)
+
+// blockDirective records an open (unmatched) block-form nolint directive
+// while a file's comments are being scanned.
+type blockDirective struct {
+	linters []string
+	isEnd   bool
+	base    BaseIssue
+}
+
+func sameLinters(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	sortedA := append([]string(nil), a...)
+	sortedB := append([]string(nil), b...)
+	sort.Strings(sortedA)
+	sort.Strings(sortedB)
+
+	for i := range sortedA {
+		if sortedA[i] != sortedB[i] {
+			return false
+		}
+	}
 
-// matches a complete nolint directive
-var fullDirectivePattern = regexp.MustCompile(`^//\s*nolint(:\s*[\w-]+\s*(?:,\s*[\w-]+\s*)*)?\s*(//.*)?\s*\n?$`)
+	return true
+}
+
+// ExplanationPolicy configures how strictly an explanation is judged beyond simply requiring one.
+type ExplanationPolicy struct {
+	MinLength        int      // minimum trimmed explanation length; zero disables the check
+	ForbiddenPhrases []string // regexes an explanation must not match, e.g. `^(?i)(fix ?me|todo|wip)\b`
+	RequiredPattern  string   // if non-empty, a regex the explanation must match, e.g. `\b[A-Z]+-\d+\b`
+}
 
 type Linter struct {
-	excludes        []string // lists individual linters that don't require explanations
-	needs           Needs    // indicates which linter checks to perform
-	excludeByLinter map[string]bool
+	excludes          []string // lists linter name patterns (may contain * and ?) that don't require explanations
+	needs             Needs    // indicates which linter checks to perform
+	excludeByLinter   map[string]bool
+	minExplanationLen int
+	forbiddenPhrases  []*regexp.Regexp
+	requiredPattern   *regexp.Regexp
 }
 
-// NewLinter creates a linter that enforces that the provided directives fulfill the provided requirements
-func NewLinter(needs Needs, excludes []string) (*Linter, error) {
+// NewLinter creates a linter that enforces that the provided directives fulfill the provided requirements.
+// excludes may contain filepath.Match-style glob patterns (e.g. "stylecheck-*") in addition to plain linter names.
+func NewLinter(needs Needs, excludes []string, policy ExplanationPolicy) (*Linter, error) {
 	excludeByName := make(map[string]bool)
 	for _, e := range excludes {
+		if _, err := filepath.Match(e, ""); err != nil {
+			return nil, fmt.Errorf("invalid exclude pattern %q: %w", e, err)
+		}
 		excludeByName[e] = true
 	}
 
+	forbiddenPhrases := make([]*regexp.Regexp, 0, len(policy.ForbiddenPhrases))
+	for _, p := range policy.ForbiddenPhrases {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid forbidden phrase pattern %q: %w", p, err)
+		}
+		forbiddenPhrases = append(forbiddenPhrases, re)
+	}
+
+	var requiredPattern *regexp.Regexp
+	if policy.RequiredPattern != "" {
+		re, err := regexp.Compile(policy.RequiredPattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid required explanation pattern %q: %w", policy.RequiredPattern, err)
+		}
+		requiredPattern = re
+	}
+
 	return &Linter{
-		needs:           needs,
-		excludeByLinter: excludeByName,
+		needs:             needs,
+		excludes:          excludes,
+		excludeByLinter:   excludeByName,
+		minExplanationLen: policy.MinLength,
+		forbiddenPhrases:  forbiddenPhrases,
+		requiredPattern:   requiredPattern,
 	}, nil
 }
 
+// isExcluded reports whether linter matches one of the configured exclude patterns.
+func (l Linter) isExcluded(linter string) bool {
+	if l.excludeByLinter[linter] {
+		return true
+	}
+
+	for _, pattern := range l.excludes {
+		if ok, err := filepath.Match(pattern, linter); err == nil && ok {
+			return true
+		}
+	}
+
+	return false
+}
+
 var leadingSpacePattern = regexp.MustCompile(`^//(\s*)`)
 var trailingBlankExplanation = regexp.MustCompile(`\s*(//\s*)?$`)
 
+// malformedExplanationPattern recognizes the one unambiguous ParseError shape:
+// a valid nolint/linter-list prefix followed by explanation text that forgot
+// its leading "//".
+var malformedExplanationPattern = regexp.MustCompile(`^(//\s*nolint(?::\s*[\w*?\[\]-]+\s*(?:,\s*[\w*?\[\]-]+\s*)*)?)\s+([^/\s].*?)\s*\n?$`)
+
+// FileIgnore records that a file has a package-comment `//nolint:...`
+// directive suppressing the listed linters (nil Checks means all linters)
+// across the whole file. PackageWide is set when File is a doc.go-style
+// file, so the caller should scope the suppression to the whole package.
+type FileIgnore struct {
+	File        string
+	Checks      []string
+	PackageWide bool
+}
+
 func (l Linter) Run(fset *token.FileSet, nodes ...ast.Node) ([]Issue, error) {
 	var issues []Issue
 
@@ -148,19 +364,283 @@ func (l Linter) Run(fset *token.FileSet, nodes ...ast.Node) ([]Issue, error) {
 			continue
 		}
 
+		var openBlocks []blockDirective
+
 		for _, c := range file.Comments {
-			issues = append(issues, l.commentAnalysis(fset, c)...)
+			fileScope := c.End() < file.Package
+
+			lineIssues, blocks := l.commentAnalysis(fset, c, fileScope)
+			issues = append(issues, lineIssues...)
+
+			for _, b := range blocks {
+				issues = append(issues, l.closeOrOpenBlock(&openBlocks, b)...)
+			}
+		}
+
+		if (l.needs & NeedsBlockPaired) != 0 {
+			for _, b := range openBlocks {
+				issues = append(issues, UnmatchedBlockStart{BaseIssue: b.base})
+			}
 		}
 	}
 
 	return issues, nil
 }
 
-func (l Linter) commentAnalysis(fset *token.FileSet, c *ast.CommentGroup) []Issue {
+// isDocFile reports whether filename is a doc.go-style file, whose
+// package comment conventionally documents (and so should be treated as
+// scoped to) the whole package rather than just that one file.
+func isDocFile(filename string) bool {
+	return strings.EqualFold(filepath.Base(filename), "doc.go")
+}
+
+// FileIgnores scans the doc comment and any other comments preceding the
+// `package` clause for file-scope `//nolint:...` directives, returning the
+// set the surrounding nolint processor should use to suppress issues across
+// the whole file.
+func (l Linter) FileIgnores(fset *token.FileSet, nodes ...ast.Node) []FileIgnore {
+	var ignores []FileIgnore
+
+	for _, node := range nodes {
+		file, ok := node.(*ast.File)
+		if !ok {
+			continue
+		}
+
+		for _, c := range file.Comments {
+			if c.End() >= file.Package {
+				continue
+			}
+
+			for _, comment := range c.List {
+				pd, ok := parseDirective(comment)
+				if !ok || pd.malformed {
+					continue
+				}
+
+				filename := fset.Position(comment.Pos()).Filename
+				ignores = append(ignores, FileIgnore{
+					File:        filename,
+					Checks:      pd.linters,
+					PackageWide: isDocFile(filename),
+				})
+			}
+		}
+	}
+
+	return ignores
+}
+
+// Ranges scans for matched start/end block directives and returns the
+// ignoredRanges the surrounding nolint processor should use to suppress
+// issues for the given linters across each range, mirroring FileIgnores. It
+// returns nil unless the Linter was built with NeedsBlockPaired, matching
+// Run/commentAnalysis's gating of start/end recognition on the same flag.
+func (l Linter) Ranges(fset *token.FileSet, nodes ...ast.Node) []*ignoredRange {
+	if (l.needs & NeedsBlockPaired) == 0 {
+		return nil
+	}
+
+	var ranges []*ignoredRange
+
+	for _, node := range nodes {
+		file, ok := node.(*ast.File)
+		if !ok {
+			continue
+		}
+
+		var open []blockDirective
+
+		for _, c := range file.Comments {
+			for _, comment := range c.List {
+				pd, ok := parseDirective(comment)
+				if !ok || pd.malformed || pd.blockMarker == "" || len(pd.invalid) > 0 {
+					continue
+				}
+
+				pos := fset.Position(comment.Pos())
+				b := blockDirective{linters: pd.linters, isEnd: pd.blockMarker == "end", base: BaseIssue{position: pos}}
+
+				if !b.isEnd {
+					open = append(open, b)
+					continue
+				}
+
+				for i := len(open) - 1; i >= 0; i-- {
+					if !sameLinters(open[i].linters, b.linters) {
+						continue
+					}
+
+					ranges = append(ranges, &ignoredRange{
+						linters: open[i].linters,
+						col:     open[i].base.position.Column,
+						Start:   open[i].base.position.Line,
+						End:     pos.Line,
+					})
+					open = append(open[:i], open[i+1:]...)
+					break
+				}
+			}
+		}
+	}
+
+	return ranges
+}
+
+// closeOrOpenBlock either opens a new block-form directive or, if it is an
+// "end" marker, closes the most recent open block with a matching linter
+// set. A mismatched or unopened "end" is reported as UnmatchedBlockEnd.
+func (l Linter) closeOrOpenBlock(openBlocks *[]blockDirective, b blockDirective) []Issue {
+	if (l.needs & NeedsBlockPaired) == 0 {
+		return nil
+	}
+
+	if !b.isEnd {
+		*openBlocks = append(*openBlocks, b)
+		return nil
+	}
+
+	for i := len(*openBlocks) - 1; i >= 0; i-- {
+		if sameLinters((*openBlocks)[i].linters, b.linters) {
+			*openBlocks = append((*openBlocks)[:i], (*openBlocks)[i+1:]...)
+			return nil
+		}
+	}
+
+	return []Issue{UnmatchedBlockEnd{BaseIssue: b.base}}
+}
+
+// deleteCommentEdit builds the TextEdit that removes an unused nolint
+// directive, including any preceding trailing whitespace on its line. If
+// nothing but that whitespace precedes the comment, the whole line
+// (including its trailing newline) is removed instead.
+func deleteCommentEdit(fset *token.FileSet, comment *ast.Comment) *TextEdit {
+	file := fset.File(comment.Pos())
+	line := file.Line(comment.Pos())
+	lineStart := file.LineStart(line)
+
+	start := trimTrailingLineWhitespace(file, lineStart, comment.Pos(), comment.Text)
+
+	if start == lineStart {
+		if line < file.LineCount() {
+			return &TextEdit{Pos: lineStart, End: file.LineStart(line + 1)}
+		}
+
+		return &TextEdit{Pos: lineStart, End: comment.End()}
+	}
+
+	return &TextEdit{Pos: start, End: comment.End()}
+}
+
+// trimTrailingLineWhitespace returns the position right after the last
+// non-space/tab byte between lineStart and pos, i.e. pos with any preceding
+// run of spaces/tabs on the same line consumed. It falls back to pos
+// unchanged if the source file content isn't available on disk, or if the
+// bytes read back don't actually have commentText sitting at pos — which
+// means disk has diverged from what was parsed (an earlier --fix pass
+// already rewrote the file, or analysis is running off an unsaved editor
+// buffer) and offsets computed against it can't be trusted.
+func trimTrailingLineWhitespace(file *token.File, lineStart, pos token.Pos, commentText string) token.Pos {
+	src, err := os.ReadFile(file.Name())
+	if err != nil {
+		return pos
+	}
+
+	lineStartOffset := file.Offset(lineStart)
+	posOffset := file.Offset(pos)
+	commentEndOffset := posOffset + len(commentText)
+	if commentEndOffset > len(src) || lineStartOffset > posOffset {
+		return pos
+	}
+
+	if string(src[posOffset:commentEndOffset]) != commentText {
+		return pos
+	}
+
+	i := posOffset
+	for i > lineStartOffset && (src[i-1] == ' ' || src[i-1] == '\t') {
+		i--
+	}
+
+	return lineStart + token.Pos(i-lineStartOffset)
+}
+
+// invalidLinterPattern pairs a linter name/pattern that failed to compile
+// with the filepath.Match error that explains why.
+type invalidLinterPattern struct {
+	name string
+	err  error
+}
+
+// parseLinterList splits the raw `:foo, bar` portion of a directive into its
+// component linter names/patterns, trimming whitespace and dropping empties.
+// Names that are not valid filepath.Match patterns are returned in invalid
+// instead of linters.
+func parseLinterList(lintersText string) (linters []string, invalid []invalidLinterPattern) {
+	if len(lintersText) == 0 {
+		return nil, nil
+	}
+
+	lls := strings.Split(lintersText[1:], ",")
+	linters = make([]string, 0, len(lls))
+	for _, ll := range lls {
+		ll = strings.TrimSpace(ll)
+		if ll == "" {
+			continue
+		}
+
+		if _, err := filepath.Match(ll, ""); err != nil {
+			invalid = append(invalid, invalidLinterPattern{name: ll, err: err})
+			continue
+		}
+
+		linters = append(linters, ll)
+	}
+
+	return linters, invalid
+}
+
+// parsedDirective is the structured shape of a single //nolint comment, as
+// derived by parseDirective. commentAnalysis, FileIgnores, and Ranges all
+// project their results from it instead of re-deriving directive shape from
+// commentPattern/fullDirectivePattern/parseLinterList themselves.
+type parsedDirective struct {
+	linters     []string
+	invalid     []invalidLinterPattern
+	blockMarker string // "", "start", or "end"
+	explanation string
+	malformed   bool // commentPattern matched but fullDirectivePattern didn't
+}
+
+// parseDirective reports whether comment is a //nolint directive at all
+// (ok), and if so its parsed shape. A malformed directive (ok with
+// malformed set) still needs its own ParseError handling by the caller.
+func parseDirective(comment *ast.Comment) (pd parsedDirective, ok bool) {
+	if !commentPattern.MatchString(comment.Text) {
+		return parsedDirective{}, false
+	}
+
+	fullMatches := fullDirectivePattern.FindStringSubmatch(comment.Text)
+	if len(fullMatches) == 0 {
+		return parsedDirective{malformed: true}, true
+	}
+
+	linters, invalid := parseLinterList(fullMatches[1])
+	return parsedDirective{
+		linters:     linters,
+		invalid:     invalid,
+		blockMarker: strings.ToLower(strings.TrimSpace(fullMatches[2])),
+		explanation: fullMatches[3],
+	}, true
+}
+
+func (l Linter) commentAnalysis(fset *token.FileSet, c *ast.CommentGroup, fileScope bool) ([]Issue, []blockDirective) {
 	var issues []Issue
+	var blocks []blockDirective
 
 	for _, comment := range c.List {
-		if !commentPattern.MatchString(comment.Text) {
+		pd, ok := parseDirective(comment)
+		if !ok {
 			continue
 		}
 
@@ -177,7 +657,7 @@ func (l Linter) commentAnalysis(fset *token.FileSet, c *ast.CommentGroup) []Issu
 		if len(parts) > 1 {
 			for _, s := range strings.Split(parts[1], ",") {
 				if strings.TrimSpace(s) == "nolintlint" {
-					return nil
+					return nil, nil
 				}
 			}
 		}
@@ -195,68 +675,126 @@ func (l Linter) commentAnalysis(fset *token.FileSet, c *ast.CommentGroup) []Issu
 
 		// check for, report and eliminate leading spaces so we can check for other issues
 		if len(leadingSpace) > 1 {
-			issues = append(issues, ExtraLeadingSpace{BaseIssue: base})
+			extraLeadingSpace := base
+			extraLeadingSpace.replacement = &TextEdit{
+				Pos:     comment.Pos(),
+				End:     comment.End(),
+				NewText: []byte("// " + comment.Text[2+len(leadingSpace):]),
+			}
+			issues = append(issues, ExtraLeadingSpace{BaseIssue: extraLeadingSpace})
 		}
 
 		if (l.needs&NeedsMachineOnly) != 0 && len(leadingSpace) > 0 {
-			issues = append(issues, NotMachine{BaseIssue: base})
+			notMachine := base
+			notMachine.replacement = &TextEdit{
+				Pos:     comment.Pos(),
+				End:     comment.End(),
+				NewText: []byte("//" + comment.Text[2+len(leadingSpace):]),
+			}
+			issues = append(issues, NotMachine{BaseIssue: notMachine})
 		}
 
-		fullMatches := fullDirectivePattern.FindStringSubmatch(comment.Text)
-		if len(fullMatches) == 0 {
-			issues = append(issues, ParseError{BaseIssue: base})
+		if pd.malformed {
+			parseError := base
+			if rewrite := malformedExplanationPattern.FindStringSubmatch(comment.Text); len(rewrite) > 0 {
+				parseError.replacement = &TextEdit{
+					Pos:     comment.Pos(),
+					End:     comment.End(),
+					NewText: []byte(rewrite[1] + " // " + rewrite[2]),
+				}
+			}
+			issues = append(issues, ParseError{BaseIssue: parseError})
 			continue
 		}
 
-		lintersText, explanation := fullMatches[1], fullMatches[2]
-		var linters []string
-		if len(lintersText) > 0 {
-			lls := strings.Split(lintersText[1:], ",")
-			linters = make([]string, 0, len(lls))
-			for _, ll := range lls {
-				ll = strings.TrimSpace(ll)
-				if ll != "" {
-					linters = append(linters, ll)
-				}
-			}
+		linters := pd.linters
+		for _, ll := range pd.invalid {
+			issues = append(issues, InvalidPattern{BaseIssue: base, Linter: ll.name, Err: ll.err})
+		}
+
+		if len(pd.invalid) > 0 {
+			continue
+		}
+
+		if (l.needs&NeedsBlockPaired) != 0 && pd.blockMarker != "" {
+			blocks = append(blocks, blockDirective{linters: linters, isEnd: pd.blockMarker == "end", base: base})
+			continue
 		}
 
 		if (l.needs & NeedsSpecific) != 0 {
 			if len(linters) == 0 {
-				issues = append(issues, NotSpecific{BaseIssue: base})
+				if fileScope {
+					issues = append(issues, OverbroadFileDirective{BaseIssue: base})
+				} else {
+					issues = append(issues, NotSpecific{BaseIssue: base})
+				}
 			}
 		}
 
 		// when detecting unused directives, we send all the directives through and filter them out in the nolint processor
 		if (l.needs & NeedsUnused) != 0 {
+			unused := base
+			unused.replacement = deleteCommentEdit(fset, comment)
+
 			if len(linters) == 0 {
-				issues = append(issues, UnusedCandidate{BaseIssue: base})
+				issues = append(issues, UnusedCandidate{BaseIssue: unused})
 			} else {
 				for _, linter := range linters {
-					issues = append(issues, UnusedCandidate{BaseIssue: base, ExpectedLinter: linter})
+					issues = append(issues, UnusedCandidate{BaseIssue: unused, ExpectedLinter: linter})
 				}
 			}
 		}
 
-		if (l.needs&NeedsExplanation) != 0 && (explanation == "" || strings.TrimSpace(explanation) == "//") {
+		if (l.needs & NeedsExplanation) != 0 {
 			needsExplanation := len(linters) == 0 // if no linters are mentioned, we must have explanation
 			// otherwise, check if we are excluding all of the mentioned linters
 			for _, ll := range linters {
-				if !l.excludeByLinter[ll] { // if a linter does require explanation
+				if !l.isExcluded(ll) { // if a linter does require explanation
 					needsExplanation = true
 					break
 				}
 			}
 
 			if needsExplanation {
-				fullDirectiveWithoutExplanation := trailingBlankExplanation.ReplaceAllString(comment.Text, "")
-				issues = append(issues, NoExplanation{
-					BaseIssue:                       base,
-					fullDirectiveWithoutExplanation: fullDirectiveWithoutExplanation,
-				})
+				if pd.explanation == "" || strings.TrimSpace(pd.explanation) == "//" {
+					fullDirectiveWithoutExplanation := trailingBlankExplanation.ReplaceAllString(comment.Text, "")
+					issues = append(issues, NoExplanation{
+						BaseIssue:                       base,
+						fullDirectiveWithoutExplanation: fullDirectiveWithoutExplanation,
+					})
+				} else {
+					issues = append(issues, l.checkExplanationPolicy(base, pd.explanation)...)
+				}
 			}
 		}
 	}
 
+	return issues, blocks
+}
+
+// checkExplanationPolicy validates a present explanation against the
+// configured ExplanationPolicy, assuming the caller has already established
+// that an explanation is required and present.
+func (l Linter) checkExplanationPolicy(base BaseIssue, explanation string) []Issue {
+	var issues []Issue
+
+	text := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(explanation), "//"))
+	text = strings.TrimSpace(text)
+
+	if l.minExplanationLen > 0 && len(text) < l.minExplanationLen {
+		issues = append(issues, WeakExplanation{BaseIssue: base})
+	} else {
+		for _, re := range l.forbiddenPhrases {
+			if re.MatchString(text) {
+				issues = append(issues, WeakExplanation{BaseIssue: base})
+				break
+			}
+		}
+	}
+
+	if l.requiredPattern != nil && !l.requiredPattern.MatchString(text) {
+		issues = append(issues, MissingExplanationRef{BaseIssue: base})
+	}
+
 	return issues
 }
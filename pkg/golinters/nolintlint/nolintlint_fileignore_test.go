@@ -0,0 +1,66 @@
+package nolintlint
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// parseFileAt writes src to path and parses it from there, so
+// fset.Position(...).Filename reflects path's basename.
+func parseFileAt(t *testing.T, path, src string) (*token.FileSet, *ast.File) {
+	t.Helper()
+
+	if err := os.WriteFile(path, []byte(src), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	return fset, file
+}
+
+func TestFileIgnoresPackageWideForDocFile(t *testing.T) {
+	src := "// nolint:stylecheck\npackage p\n"
+	path := filepath.Join(t.TempDir(), "doc.go")
+	fset, file := parseFileAt(t, path, src)
+
+	linter, err := NewLinter(NeedsAll, nil, ExplanationPolicy{})
+	if err != nil {
+		t.Fatalf("NewLinter: %v", err)
+	}
+
+	ignores := linter.FileIgnores(fset, file)
+	if len(ignores) != 1 {
+		t.Fatalf("expected 1 FileIgnore, got %d", len(ignores))
+	}
+	if !ignores[0].PackageWide {
+		t.Errorf("expected PackageWide for doc.go, got %+v", ignores[0])
+	}
+}
+
+func TestFileIgnoresNotPackageWideForRegularFile(t *testing.T) {
+	src := "// nolint:stylecheck\npackage p\n"
+	path := filepath.Join(t.TempDir(), "other.go")
+	fset, file := parseFileAt(t, path, src)
+
+	linter, err := NewLinter(NeedsAll, nil, ExplanationPolicy{})
+	if err != nil {
+		t.Fatalf("NewLinter: %v", err)
+	}
+
+	ignores := linter.FileIgnores(fset, file)
+	if len(ignores) != 1 {
+		t.Fatalf("expected 1 FileIgnore, got %d", len(ignores))
+	}
+	if ignores[0].PackageWide {
+		t.Errorf("expected PackageWide false for a regular file, got %+v", ignores[0])
+	}
+}
@@ -0,0 +1,210 @@
+package nolintlint
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// parseFileOnDisk writes src to a real file so deleteCommentEdit's
+// whitespace-trimming can read it back, then parses it from that path.
+func parseFileOnDisk(t *testing.T, src string) (*token.FileSet, *ast.File) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "test.go")
+	if err := os.WriteFile(path, []byte(src), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	return fset, file
+}
+
+func applyEdit(fset *token.FileSet, src []byte, edit *TextEdit) []byte {
+	start := fset.Position(edit.Pos).Offset
+	end := fset.Position(edit.End).Offset
+
+	out := make([]byte, 0, len(src)-(end-start)+len(edit.NewText))
+	out = append(out, src[:start]...)
+	out = append(out, edit.NewText...)
+	out = append(out, src[end:]...)
+
+	return out
+}
+
+func firstIssue(t *testing.T, issues []Issue) Issue {
+	t.Helper()
+
+	if len(issues) == 0 {
+		t.Fatal("expected at least one issue")
+	}
+
+	return issues[0]
+}
+
+func TestFixExtraLeadingSpace(t *testing.T) {
+	src := "package p\n\n//   nolint:foo // reason\nfunc f() {}\n"
+	fset, file := parseFileOnDisk(t, src)
+
+	linter, err := NewLinter(NeedsAll, nil, ExplanationPolicy{})
+	if err != nil {
+		t.Fatalf("NewLinter: %v", err)
+	}
+
+	issues, err := linter.Run(fset, file)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	var issue ExtraLeadingSpace
+	for _, i := range issues {
+		if e, ok := i.(ExtraLeadingSpace); ok {
+			issue = e
+		}
+	}
+
+	edit := issue.Replacement()
+	if edit == nil {
+		t.Fatal("expected a replacement for ExtraLeadingSpace")
+	}
+
+	got := string(applyEdit(fset, []byte(src), edit))
+	want := "package p\n\n// nolint:foo // reason\nfunc f() {}\n"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestFixNotMachine(t *testing.T) {
+	src := "package p\n\n// nolint:foo // reason\nfunc f() {}\n"
+	fset, file := parseFileOnDisk(t, src)
+
+	linter, err := NewLinter(NeedsMachineOnly, nil, ExplanationPolicy{})
+	if err != nil {
+		t.Fatalf("NewLinter: %v", err)
+	}
+
+	issues, err := linter.Run(fset, file)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	issue := firstIssue(t, issues).(NotMachine)
+	got := string(applyEdit(fset, []byte(src), issue.Replacement()))
+	want := "package p\n\n//nolint:foo // reason\nfunc f() {}\n"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestFixParseError(t *testing.T) {
+	src := "package p\n\n//nolint:foo some reason\nfunc f() {}\n"
+	fset, file := parseFileOnDisk(t, src)
+
+	linter, err := NewLinter(NeedsAll, nil, ExplanationPolicy{})
+	if err != nil {
+		t.Fatalf("NewLinter: %v", err)
+	}
+
+	issues, err := linter.Run(fset, file)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	issue := firstIssue(t, issues).(ParseError)
+	edit := issue.Replacement()
+	if edit == nil {
+		t.Fatal("expected a replacement rewriting the malformed directive")
+	}
+
+	got := string(applyEdit(fset, []byte(src), edit))
+	want := "package p\n\n//nolint:foo // some reason\nfunc f() {}\n"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestFixUnusedCandidateTrailing(t *testing.T) {
+	src := "package p\n\nfunc f() {\n\tx := 1 // nolint:unused\n\t_ = x\n}\n"
+	fset, file := parseFileOnDisk(t, src)
+
+	linter, err := NewLinter(NeedsUnused, nil, ExplanationPolicy{})
+	if err != nil {
+		t.Fatalf("NewLinter: %v", err)
+	}
+
+	issues, err := linter.Run(fset, file)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	issue := firstIssue(t, issues).(UnusedCandidate)
+	got := string(applyEdit(fset, []byte(src), issue.Replacement()))
+	want := "package p\n\nfunc f() {\n\tx := 1\n\t_ = x\n}\n"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestFixUnusedCandidateTrailingStaleDisk(t *testing.T) {
+	src := "package p\n\nfunc f() {\n\tx := 1 // nolint:unused\n\t_ = x\n}\n"
+	fset, file := parseFileOnDisk(t, src)
+
+	// Simulate the file having been rewritten on disk (e.g. by an earlier
+	// --fix pass, or an unsaved editor buffer) after the AST was parsed:
+	// the byte offsets commentAnalysis recorded no longer line up with
+	// what's actually on disk.
+	path := fset.File(file.Pos()).Name()
+	if err := os.WriteFile(path, []byte("package p\n\nfunc f() {\n\tx := 1, 2 // nolint:unused\n\t_ = x\n}\n"), 0o600); err != nil {
+		t.Fatalf("rewrite file: %v", err)
+	}
+
+	linter, err := NewLinter(NeedsUnused, nil, ExplanationPolicy{})
+	if err != nil {
+		t.Fatalf("NewLinter: %v", err)
+	}
+
+	issues, err := linter.Run(fset, file)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	issue := firstIssue(t, issues).(UnusedCandidate)
+	edit := issue.Replacement()
+
+	got := string(applyEdit(fset, []byte(src), edit))
+	want := "package p\n\nfunc f() {\n\tx := 1 \n\t_ = x\n}\n"
+	if got != want {
+		t.Errorf("got %q, want %q (expected the trim to be skipped, not to corrupt the line)", got, want)
+	}
+}
+
+func TestFixUnusedCandidateStandalone(t *testing.T) {
+	src := "package p\n\nfunc f() {\n\t// nolint:unused\n\tx := 1\n\t_ = x\n}\n"
+	fset, file := parseFileOnDisk(t, src)
+
+	linter, err := NewLinter(NeedsUnused, nil, ExplanationPolicy{})
+	if err != nil {
+		t.Fatalf("NewLinter: %v", err)
+	}
+
+	issues, err := linter.Run(fset, file)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	issue := firstIssue(t, issues).(UnusedCandidate)
+	got := string(applyEdit(fset, []byte(src), issue.Replacement()))
+	want := "package p\n\nfunc f() {\n\tx := 1\n\t_ = x\n}\n"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
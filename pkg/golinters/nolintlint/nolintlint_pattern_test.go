@@ -0,0 +1,56 @@
+package nolintlint
+
+import "testing"
+
+func TestInvalidPatternFires(t *testing.T) {
+	src := "package p\n\n//nolint:foo[ // reason\nfunc f() {}\n"
+	fset, file := parseSrc(t, src)
+
+	linter, err := NewLinter(NeedsAll, nil, ExplanationPolicy{})
+	if err != nil {
+		t.Fatalf("NewLinter: %v", err)
+	}
+
+	issues, err := linter.Run(fset, file)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	var found bool
+	for _, issue := range issues {
+		if p, ok := issue.(InvalidPattern); ok {
+			found = true
+			if p.Linter != "foo[" {
+				t.Errorf("expected Linter %q, got %q", "foo[", p.Linter)
+			}
+			if p.Err == nil {
+				t.Error("expected a non-nil Err")
+			}
+		}
+	}
+
+	if !found {
+		t.Errorf("expected an InvalidPattern issue, got %v", issues)
+	}
+}
+
+func TestValidGlobDoesNotFireInvalidPattern(t *testing.T) {
+	src := "package p\n\n//nolint:stylecheck-* // reason\nfunc f() {}\n"
+	fset, file := parseSrc(t, src)
+
+	linter, err := NewLinter(NeedsAll, nil, ExplanationPolicy{})
+	if err != nil {
+		t.Fatalf("NewLinter: %v", err)
+	}
+
+	issues, err := linter.Run(fset, file)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	for _, issue := range issues {
+		if _, ok := issue.(InvalidPattern); ok {
+			t.Errorf("unexpected InvalidPattern for a valid glob: %s", issue)
+		}
+	}
+}
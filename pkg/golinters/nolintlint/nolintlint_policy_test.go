@@ -0,0 +1,99 @@
+package nolintlint
+
+import "testing"
+
+func TestExplanationPolicyMinLength(t *testing.T) {
+	src := "package p\n\n//nolint:foo // short\nfunc f() {}\n"
+	fset, file := parseSrc(t, src)
+
+	linter, err := NewLinter(NeedsAll, nil, ExplanationPolicy{MinLength: 20})
+	if err != nil {
+		t.Fatalf("NewLinter: %v", err)
+	}
+
+	issues, err := linter.Run(fset, file)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	var found bool
+	for _, issue := range issues {
+		if _, ok := issue.(WeakExplanation); ok {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected WeakExplanation, got %v", issues)
+	}
+}
+
+func TestExplanationPolicyForbiddenPhrase(t *testing.T) {
+	src := "package p\n\n//nolint:foo // TODO fix this later\nfunc f() {}\n"
+	fset, file := parseSrc(t, src)
+
+	linter, err := NewLinter(NeedsAll, nil, ExplanationPolicy{ForbiddenPhrases: []string{`^(?i)todo\b`}})
+	if err != nil {
+		t.Fatalf("NewLinter: %v", err)
+	}
+
+	issues, err := linter.Run(fset, file)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	var found bool
+	for _, issue := range issues {
+		if _, ok := issue.(WeakExplanation); ok {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected WeakExplanation for a forbidden phrase, got %v", issues)
+	}
+}
+
+func TestExplanationPolicyRequiredPattern(t *testing.T) {
+	src := "package p\n\n//nolint:foo // see JIRA-123\nfunc f() {}\n"
+	fset, file := parseSrc(t, src)
+
+	linter, err := NewLinter(NeedsAll, nil, ExplanationPolicy{RequiredPattern: `\b[A-Z]+-\d+\b`})
+	if err != nil {
+		t.Fatalf("NewLinter: %v", err)
+	}
+
+	issues, err := linter.Run(fset, file)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	for _, issue := range issues {
+		if _, ok := issue.(MissingExplanationRef); ok {
+			t.Errorf("unexpected MissingExplanationRef: %s", issue)
+		}
+	}
+}
+
+func TestExplanationPolicyRequiredPatternMissing(t *testing.T) {
+	src := "package p\n\n//nolint:foo // no ticket reference here\nfunc f() {}\n"
+	fset, file := parseSrc(t, src)
+
+	linter, err := NewLinter(NeedsAll, nil, ExplanationPolicy{RequiredPattern: `\b[A-Z]+-\d+\b`})
+	if err != nil {
+		t.Fatalf("NewLinter: %v", err)
+	}
+
+	issues, err := linter.Run(fset, file)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	var found bool
+	for _, issue := range issues {
+		if _, ok := issue.(MissingExplanationRef); ok {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected MissingExplanationRef, got %v", issues)
+	}
+}
@@ -0,0 +1,141 @@
+package nolintlint
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"sort"
+	"testing"
+)
+
+func parseSrc(t *testing.T, src string) (*token.FileSet, *ast.File) {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "test.go", src, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	return fset, file
+}
+
+func TestBlockDirectivesMatchedRange(t *testing.T) {
+	src := `package p
+
+//nolint:errcheck start
+func f() {}
+
+//nolint:errcheck end
+`
+	fset, file := parseSrc(t, src)
+
+	linter, err := NewLinter(NeedsBlockPaired, nil, ExplanationPolicy{})
+	if err != nil {
+		t.Fatalf("NewLinter: %v", err)
+	}
+
+	issues, err := linter.Run(fset, file)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	for _, issue := range issues {
+		if _, ok := issue.(UnmatchedBlockStart); ok {
+			t.Errorf("unexpected UnmatchedBlockStart: %s", issue)
+		}
+		if _, ok := issue.(UnmatchedBlockEnd); ok {
+			t.Errorf("unexpected UnmatchedBlockEnd: %s", issue)
+		}
+	}
+
+	ranges := linter.Ranges(fset, file)
+	if len(ranges) != 1 {
+		t.Fatalf("expected 1 range, got %d", len(ranges))
+	}
+
+	r := ranges[0]
+	if r.Start != 3 || r.End != 6 {
+		t.Errorf("expected range 3-6, got %d-%d", r.Start, r.End)
+	}
+
+	if !r.matches(4, "errcheck") {
+		t.Errorf("expected range to match errcheck on line 4")
+	}
+	if r.matches(4, "gosec") {
+		t.Errorf("expected range not to match gosec")
+	}
+	if r.matches(7, "errcheck") {
+		t.Errorf("expected range not to match line 7")
+	}
+}
+
+func TestBlockDirectivesUnmatched(t *testing.T) {
+	src := `package p
+
+//nolint:errcheck start
+func f() {}
+`
+	fset, file := parseSrc(t, src)
+
+	linter, err := NewLinter(NeedsBlockPaired, nil, ExplanationPolicy{})
+	if err != nil {
+		t.Fatalf("NewLinter: %v", err)
+	}
+
+	issues, err := linter.Run(fset, file)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	var foundUnmatchedStart bool
+	for _, issue := range issues {
+		if _, ok := issue.(UnmatchedBlockStart); ok {
+			foundUnmatchedStart = true
+		}
+	}
+
+	if !foundUnmatchedStart {
+		t.Errorf("expected UnmatchedBlockStart, got %v", issues)
+	}
+
+	if ranges := linter.Ranges(fset, file); len(ranges) != 0 {
+		t.Errorf("expected no ranges for an unmatched start, got %d", len(ranges))
+	}
+}
+
+func TestRangesRequiresNeedsBlockPaired(t *testing.T) {
+	src := `package p
+
+//nolint:errcheck start
+func f() {}
+
+//nolint:errcheck end
+`
+	fset, file := parseSrc(t, src)
+
+	linter, err := NewLinter(NeedsExplanation, nil, ExplanationPolicy{})
+	if err != nil {
+		t.Fatalf("NewLinter: %v", err)
+	}
+
+	if ranges := linter.Ranges(fset, file); len(ranges) != 0 {
+		t.Errorf("expected no ranges without NeedsBlockPaired, got %d", len(ranges))
+	}
+}
+
+func TestIgnoredRangesSortByEnd(t *testing.T) {
+	ranges := ignoredRanges{
+		{Start: 1, End: 10},
+		{Start: 2, End: 3},
+		{Start: 4, End: 6},
+	}
+
+	sort.Sort(ranges)
+
+	for i := 1; i < len(ranges); i++ {
+		if ranges[i-1].End > ranges[i].End {
+			t.Fatalf("ranges not sorted by End: %+v", ranges)
+		}
+	}
+}